@@ -0,0 +1,38 @@
+package lightsail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// regionSchema returns the common "region" argument shared by Lightsail
+// resources. Lightsail resources historically pinned calls to us-east-1;
+// this lets a resource be managed from a region other than the one the
+// provider is configured for.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+}
+
+// connForRegion returns the Lightsail client to use for the resource,
+// honoring a per-resource "region" override when one differs from the
+// provider's configured region.
+func connForRegion(ctx context.Context, d *schema.ResourceData, meta interface{}) *lightsail.Client {
+	client := meta.(*conns.AWSClient)
+
+	region := d.Get("region").(string)
+	if region == "" || region == client.Region {
+		return client.LightsailClient(ctx)
+	}
+
+	return lightsail.NewFromConfig(client.Config, func(o *lightsail.Options) {
+		o.Region = region
+	})
+}
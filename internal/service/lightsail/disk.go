@@ -0,0 +1,222 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameDisk = "Disk"
+)
+
+func ResourceDisk() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDiskCreate,
+		ReadWithoutTimeout:   resourceDiskRead,
+		UpdateWithoutTimeout: resourceDiskUpdate,
+		DeleteWithoutTimeout: resourceDiskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size_in_gb": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_attached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	dName := d.Get("name").(string)
+
+	in := &lightsail.CreateDiskInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		DiskName:         aws.String(dName),
+		SizeInGb:         aws.Int32(int32(d.Get("size_in_gb").(int))),
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateDisk(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameDisk, dName, err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameDisk, dName,
+			errors.New("no operations found for CreateDisk request"))
+	}
+
+	d.SetId(dName)
+
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, defaultOperationTimeout); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForCreation, ResNameDisk, d.Id(), err)
+	}
+
+	return append(diags, resourceDiskRead(ctx, d, meta)...)
+}
+
+func resourceDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	disk, err := FindDiskByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameDisk, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameDisk, d.Id(), err)
+	}
+
+	d.Set("name", disk.Name)
+	d.Set("availability_zone", disk.Location.AvailabilityZone)
+	d.Set("size_in_gb", disk.SizeInGb)
+	d.Set("arn", disk.Arn)
+	d.Set("attached_to", disk.AttachedTo)
+	d.Set("is_attached", disk.IsAttached)
+
+	tags := KeyValueTags(ctx, disk.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameDisk, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameDisk, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameDisk, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDiskRead(ctx, d, meta)...)
+}
+
+func resourceDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	out, err := conn.DeleteDisk(ctx, &lightsail.DeleteDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameDisk, d.Id(), err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameDisk, d.Id(),
+			errors.New("no operations found for DeleteDisk request"))
+	}
+
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, defaultOperationTimeout); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForDeletion, ResNameDisk, d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindDiskByName looks up a Lightsail disk by name.
+func FindDiskByName(ctx context.Context, conn *lightsail.Client, name string) (*types.Disk, error) {
+	in := &lightsail.GetDiskInput{
+		DiskName: aws.String(name),
+	}
+
+	out, err := conn.GetDisk(ctx, in)
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		return nil, err
+	}
+
+	if out == nil || out.Disk == nil {
+		return nil, &retry.NotFoundError{LastRequest: in}
+	}
+
+	return out.Disk, nil
+}
@@ -0,0 +1,160 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameDiskAttachment = "Disk Attachment"
+)
+
+func ResourceDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDiskAttachmentCreate,
+		ReadWithoutTimeout:   resourceDiskAttachmentRead,
+		DeleteWithoutTimeout: resourceDiskAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+
+			"disk_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDiskAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	diskName := d.Get("disk_name").(string)
+	instanceName := d.Get("instance_name").(string)
+	id := fmt.Sprintf("%s,%s", diskName, instanceName)
+
+	out, err := conn.AttachDisk(ctx, &lightsail.AttachDiskInput{
+		DiskName:     aws.String(diskName),
+		InstanceName: aws.String(instanceName),
+		DiskPath:     aws.String(d.Get("disk_path").(string)),
+	})
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameDiskAttachment, id, err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameDiskAttachment, id,
+			errors.New("no operations found for AttachDisk request"))
+	}
+
+	d.SetId(id)
+
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, defaultOperationTimeout); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForCreation, ResNameDiskAttachment, d.Id(), err)
+	}
+
+	return append(diags, resourceDiskAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceDiskAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	diskName, _, err := diskAttachmentParseID(d.Id())
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameDiskAttachment, d.Id(), err)
+	}
+
+	disk, err := FindDiskByName(ctx, conn, diskName)
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameDiskAttachment, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameDiskAttachment, d.Id(), err)
+	}
+
+	if !aws.ToBool(disk.IsAttached) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameDiskAttachment, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("disk_name", disk.Name)
+	d.Set("instance_name", disk.AttachedTo)
+	d.Set("disk_path", disk.Path)
+
+	return diags
+}
+
+func resourceDiskAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	diskName, _, err := diskAttachmentParseID(d.Id())
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameDiskAttachment, d.Id(), err)
+	}
+
+	out, err := conn.DetachDisk(ctx, &lightsail.DetachDiskInput{
+		DiskName: aws.String(diskName),
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameDiskAttachment, d.Id(), err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameDiskAttachment, d.Id(),
+			errors.New("no operations found for DetachDisk request"))
+	}
+
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, defaultOperationTimeout); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForDeletion, ResNameDiskAttachment, d.Id(), err)
+	}
+
+	return diags
+}
+
+func diskAttachmentParseID(id string) (diskName, instanceName string, err error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected DiskName,InstanceName", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,131 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLightsailDiskAttachment_basic(t *testing.T) {
+	resourceName := "aws_lightsail_disk_attachment.test"
+	dName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiskAttachmentConfig_basic(dName, liName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disk_name", dName),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", liName),
+					resource.TestCheckResourceAttr(resourceName, "disk_path", "/dev/xvdf"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDiskAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No LightsailDiskAttachment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+		disk, err := tflightsail.FindDiskByName(context.Background(), conn, rs.Primary.Attributes["disk_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if !aws.ToBool(disk.IsAttached) {
+			return fmt.Errorf("Disk %q is not attached", rs.Primary.Attributes["disk_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDiskAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk_attachment" {
+			continue
+		}
+
+		disk, err := tflightsail.FindDiskByName(context.Background(), conn, rs.Primary.Attributes["disk_name"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.ToBool(disk.IsAttached) {
+			return fmt.Errorf("Lightsail Disk %q is still attached", rs.Primary.Attributes["disk_name"])
+		}
+	}
+
+	return nil
+}
+
+func testAccDiskAttachmentConfig_basic(dName string, liName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size_in_gb        = 8
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[2]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_disk_attachment" "test" {
+  disk_name     = aws_lightsail_disk.test.name
+  instance_name = aws_lightsail_instance.test.name
+  disk_path     = "/dev/xvdf"
+}
+`, dName, liName)
+}
@@ -0,0 +1,113 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailDiskSnapshot_basic(t *testing.T) {
+	resourceName := "aws_lightsail_disk_snapshot.test"
+	dName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDiskSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiskSnapshotConfig_basic(dName, sName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", sName),
+					resource.TestCheckResourceAttr(resourceName, "disk_name", dName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDiskSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No LightsailDiskSnapshot ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+		_, err := tflightsail.FindDiskSnapshotByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDiskSnapshotDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk_snapshot" {
+			continue
+		}
+
+		_, err := tflightsail.FindDiskSnapshotByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.Lightsail, create.ErrActionCheckingDestroyed, tflightsail.ResNameDiskSnapshot, rs.Primary.ID, errors.New("still exists"))
+	}
+
+	return nil
+}
+
+func testAccDiskSnapshotConfig_basic(dName string, sName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size_in_gb        = 8
+}
+
+resource "aws_lightsail_disk_snapshot" "test" {
+  name      = %[2]q
+  disk_name = aws_lightsail_disk.test.name
+}
+`, dName, sName)
+}
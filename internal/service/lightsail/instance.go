@@ -1,32 +1,60 @@
 package lightsail
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"errors"
 	"regexp"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameInstance = "Instance"
 )
 
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceInstanceCreate,
-		Read:   resourceInstanceRead,
-		Update: resourceInstanceUpdate,
-		Delete: resourceInstanceDelete,
+		CreateWithoutTimeout: resourceInstanceCreate,
+		ReadWithoutTimeout:   resourceInstanceRead,
+		UpdateWithoutTimeout: resourceInstanceUpdate,
+		DeleteWithoutTimeout: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceInstanceResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceInstanceStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			// region allows a single provider block to manage instances
+			// outside the configured region. Lightsail historically only
+			// supported us-east-1; defaults to the provider's region.
+			"region": regionSchema(),
+
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -43,9 +71,11 @@ func ResourceInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"blueprint_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_instance_snapshot_name"},
 			},
 			"bundle_id": {
 				Type:     schema.TypeString,
@@ -54,9 +84,19 @@ func ResourceInstance() *schema.Resource {
 			},
 
 			// Optional attributes
+
+			// source_instance_snapshot_name creates the instance from an
+			// aws_lightsail_instance_snapshot via CreateInstancesFromSnapshot
+			// instead of CreateInstances; mutually exclusive with blueprint_id.
+			"source_instance_snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"blueprint_id"},
+			},
 			"key_pair_name": {
-				// Not compatible with aws_key_pair (yet)
-				// We'll need a new aws_lightsail_key_pair resource
+				// Not compatible with aws_key_pair. Use the
+				// aws_lightsail_key_pair resource instead.
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
@@ -131,92 +171,128 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
-func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LightsailConn()
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	iName := d.Get("name").(string)
 
-	req := lightsail.CreateInstancesInput{
-		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
-		BlueprintId:      aws.String(d.Get("blueprint_id").(string)),
-		BundleId:         aws.String(d.Get("bundle_id").(string)),
-		InstanceNames:    aws.StringSlice([]string{iName}),
-	}
+	var operations []types.Operation
 
-	if v, ok := d.GetOk("key_pair_name"); ok {
-		req.KeyPairName = aws.String(v.(string))
-	}
+	if v, ok := d.GetOk("source_instance_snapshot_name"); ok {
+		in := &lightsail.CreateInstancesFromSnapshotInput{
+			AvailabilityZone:     aws.String(d.Get("availability_zone").(string)),
+			BundleId:             aws.String(d.Get("bundle_id").(string)),
+			InstanceNames:        []string{iName},
+			InstanceSnapshotName: aws.String(v.(string)),
+		}
 
-	if v, ok := d.GetOk("user_data"); ok {
-		req.UserData = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("key_pair_name"); ok {
+			in.KeyPairName = aws.String(v.(string))
+		}
 
-	if v, ok := d.GetOk("ip_address_type"); ok {
-		req.IpAddressType = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("user_data"); ok {
+			in.UserData = aws.String(v.(string))
+		}
 
-	if len(tags) > 0 {
-		req.Tags = Tags(tags.IgnoreAWS())
-	}
+		if v, ok := d.GetOk("ip_address_type"); ok {
+			in.IpAddressType = types.IpAddressType(v.(string))
+		}
 
-	resp, err := conn.CreateInstances(&req)
-	if err != nil {
-		return err
-	}
+		if len(tags) > 0 {
+			in.Tags = Tags(tags.IgnoreAWS())
+		}
+
+		out, err := conn.CreateInstancesFromSnapshot(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameInstance, iName, err)
+		}
+
+		operations = out.Operations
+	} else {
+		if _, ok := d.GetOk("blueprint_id"); !ok {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameInstance, iName,
+				errors.New("one of `blueprint_id` or `source_instance_snapshot_name` must be set"))
+		}
+
+		in := &lightsail.CreateInstancesInput{
+			AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+			BlueprintId:      aws.String(d.Get("blueprint_id").(string)),
+			BundleId:         aws.String(d.Get("bundle_id").(string)),
+			InstanceNames:    []string{iName},
+		}
+
+		if v, ok := d.GetOk("key_pair_name"); ok {
+			in.KeyPairName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("user_data"); ok {
+			in.UserData = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("ip_address_type"); ok {
+			in.IpAddressType = types.IpAddressType(v.(string))
+		}
+
+		if len(tags) > 0 {
+			in.Tags = Tags(tags.IgnoreAWS())
+		}
+
+		out, err := conn.CreateInstances(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameInstance, iName, err)
+		}
 
-	if len(resp.Operations) == 0 {
-		return fmt.Errorf("No operations found for CreateInstance request")
+		operations = out.Operations
 	}
 
-	op := resp.Operations[0]
-	d.SetId(d.Get("name").(string))
+	if len(operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameInstance, iName,
+			errors.New("no operations found for CreateInstance request"))
+	}
 
-	err = waitOperation(conn, op.Id)
+	d.SetId(iName)
+	if _, ok := d.GetOk("region"); !ok {
+		d.Set("region", meta.(*conns.AWSClient).Region)
+	}
 
-	if err != nil {
-		// We don't return an error here because the Create call succeeded
-		log.Printf("[ERR] Error waiting for instance (%s) to become ready: %s", d.Id(), err)
+	if err := waitOperation(ctx, conn, operations[0].Id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForCreation, ResNameInstance, d.Id(), err)
 	}
 
-	return resourceInstanceRead(d, meta)
+	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
-func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LightsailConn()
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	resp, err := conn.GetInstance(&lightsail.GetInstanceInput{
-		InstanceName: aws.String(d.Id()),
-	})
-
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok {
-			if awsErr.Code() == "NotFoundException" {
-				log.Printf("[WARN] Lightsail Instance (%s) not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			return err
-		}
-		return err
-	}
+	i, err := FindInstanceByName(ctx, conn, d.Id())
 
-	if resp == nil {
-		log.Printf("[WARN] Lightsail Instance (%s) not found, nil response from server, removing from state", d.Id())
+	if !d.IsNewResource() && retry.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameInstance, d.Id())
 		d.SetId("")
-		return nil
+		return diags
 	}
 
-	i := resp.Instance
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameInstance, d.Id(), err)
+	}
 
 	d.Set("availability_zone", i.Location.AvailabilityZone)
 	d.Set("blueprint_id", i.BlueprintId)
 	d.Set("bundle_id", i.BundleId)
 	d.Set("key_pair_name", i.SshKeyName)
 	d.Set("name", i.Name)
+	if _, ok := d.GetOk("region"); !ok {
+		d.Set("region", meta.(*conns.AWSClient).Region)
+	}
 
 	// additional attributes
 	d.Set("arn", i.Arn)
@@ -230,82 +306,164 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("ipv6_address", i.Ipv6Addresses[0])
 	}
 
-	d.Set("ipv6_addresses", aws.StringValueSlice(i.Ipv6Addresses))
+	d.Set("ipv6_addresses", i.Ipv6Addresses)
 	d.Set("ip_address_type", i.IpAddressType)
 	d.Set("is_static_ip", i.IsStaticIp)
 	d.Set("private_ip_address", i.PrivateIpAddress)
 	d.Set("public_ip_address", i.PublicIpAddress)
 
-	tags := KeyValueTags(i.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := KeyValueTags(ctx, i.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameInstance, d.Id(), err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameInstance, d.Id(), err)
 	}
 
-	return nil
+	return diags
 }
 
-func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LightsailConn()
-	resp, err := conn.DeleteInstance(&lightsail.DeleteInstanceInput{
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	out, err := conn.DeleteInstance(ctx, &lightsail.DeleteInstanceInput{
 		InstanceName: aws.String(d.Id()),
 	})
 
 	if err != nil {
-		return err
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameInstance, d.Id(), err)
 	}
 
-	op := resp.Operations[0]
-
-	err = waitOperation(conn, op.Id)
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameInstance, d.Id(),
+			errors.New("no operations found for DeleteInstance request"))
+	}
 
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for instance (%s) to become destroyed: %s",
-			d.Id(), err)
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForDeletion, ResNameInstance, d.Id(), err)
 	}
 
-	return nil
+	return diags
 }
 
-func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LightsailConn()
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
 
 	if d.HasChange("ip_address_type") {
-		resp, err := conn.SetIpAddressType(&lightsail.SetIpAddressTypeInput{
+		out, err := conn.SetIpAddressType(ctx, &lightsail.SetIpAddressTypeInput{
 			ResourceName:  aws.String(d.Id()),
-			ResourceType:  aws.String("Instance"),
-			IpAddressType: aws.String(d.Get("ip_address_type").(string)),
+			ResourceType:  types.ResourceTypeInstance,
+			IpAddressType: types.IpAddressType(d.Get("ip_address_type").(string)),
 		})
 
 		if err != nil {
-			return err
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameInstance, d.Id(), err)
 		}
 
-		if len(resp.Operations) == 0 {
-			return fmt.Errorf("No operations found for CreateInstance request")
+		if len(out.Operations) == 0 {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameInstance, d.Id(),
+				errors.New("no operations found for SetIpAddressType request"))
 		}
 
-		op := resp.Operations[0]
-
-		err = waitOperation(conn, op.Id)
-		if err != nil {
-			return err
+		if err := waitOperation(ctx, conn, out.Operations[0].Id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameInstance, d.Id(), err)
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating Lightsail Instance (%s) tags: %s", d.Id(), err)
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameInstance, d.Id(), err)
 		}
 	}
 
-	return resourceInstanceRead(d, meta)
+	return append(diags, resourceInstanceRead(ctx, d, meta)...)
+}
+
+// FindInstanceByName looks up a Lightsail instance by name, returning a
+// retry.NotFoundError (so tfresource.NotFound/retry.NotFound recognize it)
+// when Lightsail doesn't know about it.
+func FindInstanceByName(ctx context.Context, conn *lightsail.Client, name string) (*types.Instance, error) {
+	in := &lightsail.GetInstanceInput{
+		InstanceName: aws.String(name),
+	}
+
+	out, err := conn.GetInstance(ctx, in)
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		return nil, err
+	}
+
+	if out == nil || out.Instance == nil {
+		return nil, &retry.NotFoundError{LastRequest: in}
+	}
+
+	return out.Instance, nil
+}
+
+// resourceInstanceResourceV0 is the pre-region schema, used only to decode
+// prior state during the V0 -> V1 upgrade below.
+func resourceInstanceResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":               {Type: schema.TypeString},
+			"availability_zone":  {Type: schema.TypeString},
+			"blueprint_id":       {Type: schema.TypeString},
+			"bundle_id":          {Type: schema.TypeString},
+			"key_pair_name":      {Type: schema.TypeString},
+			"user_data":          {Type: schema.TypeString},
+			"arn":                {Type: schema.TypeString},
+			"created_at":         {Type: schema.TypeString},
+			"cpu_count":          {Type: schema.TypeInt},
+			"ram_size":           {Type: schema.TypeFloat},
+			"ip_address_type":    {Type: schema.TypeString},
+			"ipv6_address":       {Type: schema.TypeString},
+			"is_static_ip":       {Type: schema.TypeBool},
+			"private_ip_address": {Type: schema.TypeString},
+			"public_ip_address":  {Type: schema.TypeString},
+			"username":           {Type: schema.TypeString},
+			"ipv6_addresses": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+// resourceInstanceStateUpgradeV0 adds the new "region" attribute to state
+// predating it, preserving the instance's existing ID. Instances keep
+// running in whatever region they were originally created in, so we
+// default to the provider's configured region rather than re-deriving it
+// from the API.
+func resourceInstanceStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	if _, ok := rawState["region"]; !ok {
+		rawState["region"] = meta.(*conns.AWSClient).Region
+	}
+
+	return rawState, nil
 }
@@ -0,0 +1,212 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameInstancePublicPorts = "Instance Public Ports"
+)
+
+func ResourceInstancePublicPorts() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstancePublicPortsCreate,
+		ReadWithoutTimeout:   resourceInstancePublicPortsRead,
+		UpdateWithoutTimeout: resourceInstancePublicPortsCreate,
+		DeleteWithoutTimeout: resourceInstancePublicPortsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// PutInstancePublicPorts is authoritative: whatever is sent
+			// here fully replaces the instance's open ports.
+			"port_info": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"to_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enum.Values[types.NetworkProtocol](), false),
+						},
+						"cidrs": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ipv6_cidrs": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cidr_list_aliases": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceInstancePublicPortsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	iName := d.Get("instance_name").(string)
+
+	_, err := conn.PutInstancePublicPorts(ctx, &lightsail.PutInstancePublicPortsInput{
+		InstanceName: aws.String(iName),
+		PortInfos:    expandInstancePortInfos(d.Get("port_info").(*schema.Set).List()),
+	})
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameInstancePublicPorts, iName, err)
+	}
+
+	d.SetId(iName)
+
+	return append(diags, resourceInstancePublicPortsRead(ctx, d, meta)...)
+}
+
+func resourceInstancePublicPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	portStates, err := FindInstancePortStatesByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameInstancePublicPorts, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameInstancePublicPorts, d.Id(), err)
+	}
+
+	d.Set("instance_name", d.Id())
+
+	if err := d.Set("port_info", flattenInstancePortStates(portStates)); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameInstancePublicPorts, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceInstancePublicPortsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	_, err := conn.PutInstancePublicPorts(ctx, &lightsail.PutInstancePublicPortsInput{
+		InstanceName: aws.String(d.Id()),
+		PortInfos:    []types.PortInfo{},
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameInstancePublicPorts, d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindInstancePortStatesByName returns the open port set for a Lightsail
+// instance.
+func FindInstancePortStatesByName(ctx context.Context, conn *lightsail.Client, name string) ([]types.InstancePortState, error) {
+	in := &lightsail.GetInstancePortStatesInput{
+		InstanceName: aws.String(name),
+	}
+
+	out, err := conn.GetInstancePortStates(ctx, in)
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, &retry.NotFoundError{LastRequest: in}
+	}
+
+	return out.PortStates, nil
+}
+
+func expandInstancePortInfos(tfList []interface{}) []types.PortInfo {
+	portInfos := make([]types.PortInfo, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		portInfos = append(portInfos, types.PortInfo{
+			FromPort:        aws.Int32(int32(tfMap["from_port"].(int))),
+			ToPort:          aws.Int32(int32(tfMap["to_port"].(int))),
+			Protocol:        types.NetworkProtocol(tfMap["protocol"].(string)),
+			Cidrs:           flex.ExpandStringValueSet(tfMap["cidrs"].(*schema.Set)),
+			Ipv6Cidrs:       flex.ExpandStringValueSet(tfMap["ipv6_cidrs"].(*schema.Set)),
+			CidrListAliases: flex.ExpandStringValueSet(tfMap["cidr_list_aliases"].(*schema.Set)),
+		})
+	}
+
+	return portInfos
+}
+
+func flattenInstancePortStates(portStates []types.InstancePortState) []interface{} {
+	tfList := make([]interface{}, 0, len(portStates))
+
+	for _, portState := range portStates {
+		tfList = append(tfList, map[string]interface{}{
+			"from_port":         aws.ToInt32(portState.FromPort),
+			"to_port":           aws.ToInt32(portState.ToPort),
+			"protocol":          string(portState.Protocol),
+			"cidrs":             flex.FlattenStringValueList(portState.Cidrs),
+			"ipv6_cidrs":        flex.FlattenStringValueList(portState.Ipv6Cidrs),
+			"cidr_list_aliases": flex.FlattenStringValueList(portState.CidrListAliases),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,203 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailInstancePublicPorts_basic(t *testing.T) {
+	resourceName := "aws_lightsail_instance_public_ports.test"
+	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancePublicPortsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancePublicPortsConfig_basic(liName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancePublicPortsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", liName),
+					resource.TestCheckResourceAttr(resourceName, "port_info.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_info.*", map[string]string{
+						"from_port": "80",
+						"to_port":   "80",
+						"protocol":  "tcp",
+					}),
+				),
+			},
+			{
+				Config: testAccInstancePublicPortsConfig_updated(liName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancePublicPortsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port_info.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_info.*", map[string]string{
+						"from_port": "8080",
+						"to_port":   "8080",
+						"protocol":  "tcp",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailInstancePublicPorts_disappears(t *testing.T) {
+	resourceName := "aws_lightsail_instance_public_ports.test"
+	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancePublicPortsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancePublicPortsConfig_basic(liName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancePublicPortsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tflightsail.ResourceInstancePublicPorts(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstancePublicPortsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No LightsailInstancePublicPorts ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+		_, err := tflightsail.FindInstancePortStatesByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstancePublicPortsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_instance_public_ports" {
+			continue
+		}
+
+		portStates, err := tflightsail.FindInstancePortStatesByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(portStates) == 0 {
+			continue
+		}
+
+		return create.Error(names.Lightsail, create.ErrActionCheckingDestroyed, tflightsail.ResNameInstancePublicPorts, rs.Primary.ID, errors.New("still exists"))
+	}
+
+	return nil
+}
+
+func testAccInstancePublicPortsConfig_basic(liName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_public_ports" "test" {
+  instance_name = aws_lightsail_instance.test.name
+
+  port_info {
+    protocol  = "tcp"
+    from_port = 80
+    to_port   = 80
+  }
+
+  port_info {
+    protocol   = "udp"
+    from_port  = 443
+    to_port    = 443
+    cidrs      = ["0.0.0.0/0"]
+    ipv6_cidrs = ["::/0"]
+  }
+}
+`, liName)
+}
+
+func testAccInstancePublicPortsConfig_updated(liName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_public_ports" "test" {
+  instance_name = aws_lightsail_instance.test.name
+
+  port_info {
+    protocol  = "tcp"
+    from_port = 8080
+    to_port   = 8080
+  }
+}
+`, liName)
+}
@@ -0,0 +1,215 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameInstanceSnapshot = "Instance Snapshot"
+)
+
+func ResourceInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceSnapshotCreate,
+		ReadWithoutTimeout:   resourceInstanceSnapshotRead,
+		UpdateWithoutTimeout: resourceInstanceSnapshotUpdate,
+		DeleteWithoutTimeout: resourceInstanceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	sName := d.Get("name").(string)
+
+	in := &lightsail.CreateInstanceSnapshotInput{
+		InstanceName:         aws.String(d.Get("instance_name").(string)),
+		InstanceSnapshotName: aws.String(sName),
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateInstanceSnapshot(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameInstanceSnapshot, sName, err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameInstanceSnapshot, sName,
+			errors.New("no operations found for CreateInstanceSnapshot request"))
+	}
+
+	d.SetId(sName)
+
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, defaultOperationTimeout); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForCreation, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	return append(diags, resourceInstanceSnapshotRead(ctx, d, meta)...)
+}
+
+func resourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	snap, err := FindInstanceSnapshotByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameInstanceSnapshot, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	d.Set("name", snap.Name)
+	d.Set("instance_name", snap.FromInstanceName)
+	d.Set("arn", snap.Arn)
+	d.Set("size_in_gb", snap.SizeInGb)
+	d.Set("state", string(snap.State))
+
+	tags := KeyValueTags(ctx, snap.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameInstanceSnapshot, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstanceSnapshotRead(ctx, d, meta)...)
+}
+
+func resourceInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	out, err := conn.DeleteInstanceSnapshot(ctx, &lightsail.DeleteInstanceSnapshotInput{
+		InstanceSnapshotName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameInstanceSnapshot, d.Id(),
+			errors.New("no operations found for DeleteInstanceSnapshot request"))
+	}
+
+	if err := waitOperation(ctx, conn, out.Operations[0].Id, defaultOperationTimeout); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForDeletion, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindInstanceSnapshotByName looks up a Lightsail instance snapshot by name.
+func FindInstanceSnapshotByName(ctx context.Context, conn *lightsail.Client, name string) (*types.InstanceSnapshot, error) {
+	in := &lightsail.GetInstanceSnapshotInput{
+		InstanceSnapshotName: aws.String(name),
+	}
+
+	out, err := conn.GetInstanceSnapshot(ctx, in)
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		return nil, err
+	}
+
+	if out == nil || out.InstanceSnapshot == nil {
+		return nil, &retry.NotFoundError{LastRequest: in}
+	}
+
+	return out.InstanceSnapshot, nil
+}
@@ -0,0 +1,72 @@
+package lightsail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstanceSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+
+	snap, err := FindInstanceSnapshotByName(ctx, conn, name)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameInstanceSnapshot, name, err)
+	}
+
+	d.SetId(aws.ToString(snap.Name))
+	d.Set("instance_name", snap.FromInstanceName)
+	d.Set("arn", snap.Arn)
+	d.Set("size_in_gb", snap.SizeInGb)
+	d.Set("state", string(snap.State))
+
+	tags := KeyValueTags(ctx, snap.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameInstanceSnapshot, d.Id(), err)
+	}
+
+	return diags
+}
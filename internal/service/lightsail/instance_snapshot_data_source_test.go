@@ -0,0 +1,46 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLightsailInstanceSnapshotDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_lightsail_instance_snapshot.test"
+	resourceName := "aws_lightsail_instance_snapshot.test"
+	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceSnapshotDataSourceConfig_basic(liName, sName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_name", resourceName, "instance_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceSnapshotDataSourceConfig_basic(liName string, sName string) string {
+	return testAccInstanceSnapshotConfig_basic(liName, sName) + `
+data "aws_lightsail_instance_snapshot" "test" {
+  name = aws_lightsail_instance_snapshot.test.name
+}
+`
+}
@@ -0,0 +1,197 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailInstanceSnapshot_basic(t *testing.T) {
+	resourceName := "aws_lightsail_instance_snapshot.test"
+	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceSnapshotConfig_basic(liName, sName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", sName),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", liName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailInstance_fromSnapshot(t *testing.T) {
+	originalResourceName := "aws_lightsail_instance.test"
+	restoredResourceName := "aws_lightsail_instance.restored"
+	liName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	sName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	riName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_fromSnapshot(liName, sName, riName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceSnapshotExists("aws_lightsail_instance_snapshot.test"),
+					testAccCheckInstanceExists(originalResourceName),
+					testAccCheckInstanceExists(restoredResourceName),
+					resource.TestCheckResourceAttr(restoredResourceName, "name", riName),
+					resource.TestCheckResourceAttr(restoredResourceName, "source_instance_snapshot_name", sName),
+					resource.TestCheckResourceAttrSet(restoredResourceName, "blueprint_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No LightsailInstance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+		_, err := tflightsail.FindInstanceByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstanceSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No LightsailInstanceSnapshot ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+		_, err := tflightsail.FindInstanceSnapshotByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstanceSnapshotDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_instance_snapshot" {
+			continue
+		}
+
+		_, err := tflightsail.FindInstanceSnapshotByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.Lightsail, create.ErrActionCheckingDestroyed, tflightsail.ResNameInstanceSnapshot, rs.Primary.ID, errors.New("still exists"))
+	}
+
+	return nil
+}
+
+func testAccInstanceSnapshotConfig_basic(liName string, sName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_snapshot" "test" {
+  name          = %[2]q
+  instance_name = aws_lightsail_instance.test.name
+}
+`, liName, sName)
+}
+
+func testAccInstanceConfig_fromSnapshot(liName string, sName string, riName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = data.aws_availability_zones.available.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_snapshot" "test" {
+  name          = %[2]q
+  instance_name = aws_lightsail_instance.test.name
+}
+
+resource "aws_lightsail_instance" "restored" {
+  name                          = %[3]q
+  availability_zone             = data.aws_availability_zones.available.names[0]
+  bundle_id                     = "nano_1_0"
+  source_instance_snapshot_name = aws_lightsail_instance_snapshot.test.name
+}
+`, liName, sName, riName)
+}
@@ -0,0 +1,271 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameKeyPair = "Key Pair"
+)
+
+func ResourceKeyPair() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyPairCreate,
+		ReadWithoutTimeout:   resourceKeyPairRead,
+		UpdateWithoutTimeout: resourceKeyPairUpdate,
+		DeleteWithoutTimeout: resourceKeyPairDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+
+			// Name is optional/computed so we can generate a name if
+			// one is not provided, similar to aws_key_pair.
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// pgp_key is only used when Lightsail generates the key pair
+			// for us (i.e. public_key is not set) and we need to return
+			// an encrypted copy of the private key.
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// public_key is optional; when omitted Lightsail will
+			// generate a new key pair for us via CreateKeyPair. When
+			// set, the supplied public key is imported via ImportKeyPair
+			// and no private key material is returned.
+			"public_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"encrypted_private_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	var kName string
+	if v, ok := d.GetOk("name"); ok {
+		kName = v.(string)
+	} else {
+		kName = resource.PrefixedUniqueId("tf-")
+		d.Set("name", kName)
+	}
+
+	// When a public key is supplied we import it as-is and Lightsail
+	// never hands us back any private key material.
+	if pubKey, ok := d.GetOk("public_key"); ok {
+		in := &lightsail.ImportKeyPairInput{
+			KeyPairName:     aws.String(kName),
+			PublicKeyBase64: aws.String(pubKey.(string)),
+		}
+
+		_, err := conn.ImportKeyPair(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameKeyPair, kName, err)
+		}
+
+		d.SetId(kName)
+		return append(diags, resourceKeyPairUpdate(ctx, d, meta)...)
+	}
+
+	in := &lightsail.CreateKeyPairInput{
+		KeyPairName: aws.String(kName),
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateKeyPair(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameKeyPair, kName, err)
+	}
+
+	d.SetId(kName)
+	d.Set("public_key", out.KeyPair.PublicKey)
+	d.Set("fingerprint", out.KeyPair.Fingerprint)
+	d.Set("arn", out.KeyPair.Arn)
+
+	if pgpKey, ok := d.GetOk("pgp_key"); ok {
+		encryptionKey, err := verify.RetrieveGPGKey(pgpKey.(string))
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameKeyPair, kName, err)
+		}
+
+		fingerprint, encrypted, err := verify.EncryptValue(encryptionKey, aws.ToString(out.PrivateKeyBase64), "Lightsail Key Pair Private Key")
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResNameKeyPair, kName, err)
+		}
+
+		d.Set("encrypted_fingerprint", fingerprint)
+		d.Set("encrypted_private_key", encrypted)
+	} else {
+		d.Set("private_key", out.PrivateKeyBase64)
+	}
+
+	return append(diags, resourceKeyPairRead(ctx, d, meta)...)
+}
+
+func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	kp, err := FindKeyPairByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResNameKeyPair, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResNameKeyPair, d.Id(), err)
+	}
+
+	d.Set("name", kp.Name)
+	d.Set("arn", kp.Arn)
+	d.Set("fingerprint", kp.Fingerprint)
+	if _, ok := d.GetOk("region"); !ok {
+		d.Set("region", meta.(*conns.AWSClient).Region)
+	}
+
+	tags := KeyValueTags(ctx, kp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameKeyPair, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResNameKeyPair, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionUpdating, ResNameKeyPair, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyPairRead(ctx, d, meta)...)
+}
+
+func resourceKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := connForRegion(ctx, d, meta)
+
+	_, err := conn.DeleteKeyPair(ctx, &lightsail.DeleteKeyPairInput{
+		KeyPairName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResNameKeyPair, d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindKeyPairByName looks up a Lightsail key pair by name.
+func FindKeyPairByName(ctx context.Context, conn *lightsail.Client, name string) (*types.KeyPair, error) {
+	in := &lightsail.GetKeyPairInput{
+		KeyPairName: aws.String(name),
+	}
+
+	out, err := conn.GetKeyPair(ctx, in)
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		return nil, err
+	}
+
+	if out == nil || out.KeyPair == nil {
+		return nil, &retry.NotFoundError{LastRequest: in}
+	}
+
+	return out.KeyPair, nil
+}
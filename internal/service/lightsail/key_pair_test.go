@@ -0,0 +1,146 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailKeyPair_basic(t *testing.T) {
+	var kp types.KeyPair
+	resourceName := "aws_lightsail_key_pair.test"
+	kName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPairConfig_basic(kName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPairExists(resourceName, &kp),
+					resource.TestCheckResourceAttr(resourceName, "name", kName),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailKeyPair_importExisting(t *testing.T) {
+	var kp types.KeyPair
+	resourceName := "aws_lightsail_key_pair.test"
+	kName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPairConfig_publicKey(kName, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyPairExists(resourceName, &kp),
+					resource.TestCheckResourceAttr(resourceName, "name", kName),
+					resource.TestCheckResourceAttr(resourceName, "private_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyPairExists(n string, kp *types.KeyPair) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No LightsailKeyPair ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+		out, err := tflightsail.FindKeyPairByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*kp = *out
+
+		return nil
+	}
+}
+
+func testAccCheckKeyPairDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(context.Background())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_key_pair" {
+			continue
+		}
+
+		_, err := tflightsail.FindKeyPairByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.Lightsail, create.ErrActionCheckingDestroyed, tflightsail.ResNameKeyPair, rs.Primary.ID, errors.New("still exists"))
+	}
+
+	return nil
+}
+
+func testAccKeyPairConfig_basic(kName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_key_pair" "test" {
+  name = %[1]q
+}
+`, kName)
+}
+
+func testAccKeyPairConfig_publicKey(kName string, publicKey string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_key_pair" "test" {
+  name       = %[1]q
+  public_key = %[2]q
+}
+`, kName, publicKey)
+}
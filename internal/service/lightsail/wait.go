@@ -0,0 +1,55 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// defaultOperationTimeout is used by resources that poll operations but do
+// not yet expose a configurable Timeouts block.
+const defaultOperationTimeout = 15 * time.Minute
+
+// waitOperation polls a Lightsail operation until it reaches a terminal
+// status, honoring the caller's context deadline and the passed timeout.
+func waitOperation(ctx context.Context, conn *lightsail.Client, oid *string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(types.OperationStatusStarted), string(types.OperationStatusNotStarted)},
+		Target:  []string{string(types.OperationStatusSucceeded), string(types.OperationStatusCompleted)},
+		Refresh: statusOperation(ctx, conn, oid),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func statusOperation(ctx context.Context, conn *lightsail.Client, oid *string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.GetOperation(ctx, &lightsail.GetOperationInput{
+			OperationId: oid,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if out.Operation == nil {
+			return nil, "", nil
+		}
+
+		op := out.Operation
+
+		if op.Status == types.OperationStatusFailed {
+			return op, string(op.Status), fmt.Errorf("operation (%s) failed: errorCode=%s errorDetails=%s",
+				aws.ToString(oid), aws.ToString(op.ErrorCode), aws.ToString(op.ErrorDetails))
+		}
+
+		return op, string(op.Status), nil
+	}
+}